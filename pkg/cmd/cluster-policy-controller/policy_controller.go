@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/pprof"
 	"os"
+	"runtime"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/openshift/library-go/pkg/serviceability"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
 
 	"k8s.io/klog"
@@ -22,6 +27,8 @@ import (
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 
 	openshiftcontrolplanev1 "github.com/openshift/api/openshiftcontrolplane/v1"
@@ -39,20 +46,56 @@ func RunClusterPolicyController(config *openshiftcontrolplanev1.OpenShiftControl
 		return err
 	}
 
+	// debugMux collects /healthz and /debug/pprof/*, and is shared with
+	// origincontrollers.RunControllerServer so both sets of routes are served from the
+	// same listener as /metrics.
+	debugMux := http.NewServeMux()
+	// healthChecks backs the aggregate /healthz view; startControllers registers each
+	// controller's HealthCheck against it as that controller starts.
+	healthChecks := newControllerHealthRegistry()
+	registerDebugHandlers(debugMux, config, kubeClient, healthChecks)
+
 	// only serve if we have serving information.
 	if config.ServingInfo != nil {
 		klog.Infof("Starting controllers on %s (%s)", config.ServingInfo.BindAddress, version.Get().String())
 
-		if err := origincontrollers.RunControllerServer(*config.ServingInfo, kubeClient); err != nil {
+		if err := origincontrollers.RunControllerServer(*config.ServingInfo, kubeClient, debugMux); err != nil {
 			return err
 		}
 	}
 
 	originControllerManager := func(ctx context.Context) {
-		if err := WaitForHealthyAPIServer(kubeClient.Discovery().RESTClient()); err != nil {
+		// InitialDelay is a field this request assumes on OpenShiftControllerManagerConfig;
+		// it isn't vendored in this tree yet, so it needs to land upstream in
+		// github.com/openshift/api before this builds against the real dependency.
+		if config.InitialDelay.Duration > 0 {
+			klog.Infof("Waiting %s before starting controllers (initialDelay)", config.InitialDelay.Duration)
+			select {
+			case <-time.After(config.InitialDelay.Duration):
+			case <-ctx.Done():
+				return
+			}
+		}
+		// Each preflight check below takes ctx so that losing leadership while still
+		// preflighting cancels the wait promptly instead of blocking up to its full
+		// timeout; ctx.Err() is checked before klog.Fatal so a cancellation isn't
+		// mistaken for a real preflight failure.
+		if err := waitForKubeControllerManagerReady(ctx, kubeClient); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			klog.Fatal(err)
+		}
+		if err := WaitForHealthyAPIServer(ctx, kubeClient.Discovery().RESTClient()); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
 			klog.Fatal(err)
 		}
-		if err := WaitForAuthorizationUpdate(kubeClient.AuthorizationV1()); err != nil {
+		if err := WaitForAuthorizationUpdate(ctx, kubeClient.AuthorizationV1(), origincontrollers.ControllerInitializers); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
 			klog.Fatal(err)
 		}
 
@@ -60,7 +103,7 @@ func RunClusterPolicyController(config *openshiftcontrolplanev1.OpenShiftControl
 		if err != nil {
 			klog.Fatal(err)
 		}
-		if err := startControllers(controllerContext); err != nil {
+		if err := startControllers(controllerContext, debugMux, healthChecks); err != nil {
 			klog.Fatal(err)
 		}
 		controllerContext.StartInformers(ctx.Done())
@@ -74,8 +117,17 @@ func RunClusterPolicyController(config *openshiftcontrolplanev1.OpenShiftControl
 	if err != nil {
 		return err
 	}
+	// resourcelock.New understands the "configmapsleases"/"endpointsleases" hybrid lock
+	// types natively, writing ownership metadata to both the old and new locks so a
+	// rolling restart can migrate from configmaps to leases with zero downtime:
+	// old-holders and new-holders agree on who's leading throughout.
+	//
+	// LeaderElection.ResourceLock is a field this request assumes on
+	// OpenShiftControllerManagerConfig; it isn't vendored in this tree yet, so it needs
+	// to land upstream in github.com/openshift/api before this builds against the real
+	// dependency.
 	rl, err := resourcelock.New(
-		"configmaps",
+		resolveResourceLockType(config.LeaderElection.ResourceLock),
 		// namespace where cluster-policy-controller container runs in static pod
 		"openshift-kube-controller-manager",
 		"cluster-policy-controller",
@@ -88,6 +140,7 @@ func RunClusterPolicyController(config *openshiftcontrolplanev1.OpenShiftControl
 	if err != nil {
 		return err
 	}
+	leaderLost := make(chan struct{})
 	go leaderelection.RunOrDie(context.Background(),
 		leaderelection.LeaderElectionConfig{
 			Lock:          rl,
@@ -97,30 +150,94 @@ func RunClusterPolicyController(config *openshiftcontrolplanev1.OpenShiftControl
 			Callbacks: leaderelection.LeaderCallbacks{
 				OnStartedLeading: originControllerManager,
 				OnStoppedLeading: func() {
-					klog.Fatalf("leaderelection lost")
+					klog.Warning("leaderelection lost, shutting down")
+					eventRecorder.Eventf(&v1.ObjectReference{Kind: "Pod", Name: id, Namespace: "openshift-kube-controller-manager"},
+						v1.EventTypeWarning, "LeaderLost", "%s stopped leading", id)
+					close(leaderLost)
 				},
 			},
 		})
 
-	return nil
+	<-leaderLost
+
+	// Losing leadership cancels originControllerManager's ctx (passed through above),
+	// so an in-flight preflight check returns promptly instead of blocking up to its
+	// full timeout. Controllers startControllers already started are fire-and-forget:
+	// InitFn returns as soon as a controller is launched, without reporting when its
+	// own goroutines actually stop, so there's no signal here to block on — shutdown
+	// is immediate, not a bounded drain.
+	return fmt.Errorf("leaderelection lost")
 }
 
-func WaitForHealthyAPIServer(client rest.Interface) error {
-	var healthzContent string
-	// If apiserver is not running we should wait for some time and fail only then. This is particularly
-	// important when we start apiserver and controller manager at the same time.
-	err := wait.PollImmediate(time.Second, 5*time.Minute, func() (bool, error) {
-		healthStatus := 0
-		resp := client.Get().AbsPath("/healthz").Do(context.TODO()).StatusCode(&healthStatus)
-		if healthStatus != http.StatusOK {
-			klog.Errorf("Server isn't healthy yet. Waiting a little while.")
+// resolveResourceLockType returns configured, or resourcelock.ConfigMapsResourceLock if
+// it's unset, preserving the historical default for existing configs that don't set
+// LeaderElection.ResourceLock so they keep using configmap-based election.
+func resolveResourceLockType(configured string) string {
+	if len(configured) == 0 {
+		return resourcelock.ConfigMapsResourceLock
+	}
+	return configured
+}
+
+// kubeControllerManagerContainerName is the name of the sibling container this
+// controller runs alongside when deployed as a static pod in
+// openshift-kube-controller-manager.
+const kubeControllerManagerContainerName = "kube-controller-manager"
+
+// waitForKubeControllerManagerReady blocks until the neighboring
+// kube-controller-manager container in this pod reports Ready, as observed
+// through the downward API (POD_NAME/POD_NAMESPACE) and the kubelet-reported
+// pod status. This avoids starting controllers in the middle of a static pod
+// rollout, where kube-controller-manager itself may still be restarting. When
+// the pod/namespace env vars aren't set, or no such sibling container exists,
+// this is a no-op so standalone (non-static-pod) deployments are unaffected.
+// It returns early if ctx is canceled (e.g. leadership lost while still
+// preflighting) instead of running the full 5 minutes out.
+func waitForKubeControllerManagerReady(ctx context.Context, kubeClient kubernetes.Interface) error {
+	podName := os.Getenv("POD_NAME")
+	podNamespace := os.Getenv("POD_NAMESPACE")
+	if len(podName) == 0 || len(podNamespace) == 0 {
+		return nil
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	return wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		pod, err := kubeClient.CoreV1().Pods(podNamespace).Get(pollCtx, podName, metav1.GetOptions{})
+		if err != nil {
+			klog.Warningf("Unable to get own pod %s/%s, will retry: %v", podNamespace, podName, err)
 			return false, nil
 		}
-		content, _ := resp.Raw()
-		healthzContent = string(content)
-
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != kubeControllerManagerContainerName {
+				continue
+			}
+			if !status.Ready {
+				klog.Infof("Waiting for %q container to become ready before starting controllers", kubeControllerManagerContainerName)
+			}
+			return status.Ready, nil
+		}
+		// no sibling container found, nothing to wait for
 		return true, nil
-	})
+	}, pollCtx.Done())
+}
+
+// WaitForHealthyAPIServer blocks until the API server's /healthz reports OK, returning
+// early if ctx is canceled (e.g. leadership lost while still preflighting) instead of
+// running the full 5 minutes out.
+func WaitForHealthyAPIServer(ctx context.Context, client rest.Interface) error {
+	pollCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var healthzContent string
+	// If apiserver is not running we should wait for some time and fail only then. This is particularly
+	// important when we start apiserver and controller manager at the same time.
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		ok, content, err := apiServerHealthz(pollCtx, client)
+		healthzContent = content
+		return ok, err
+	}, pollCtx.Done())
 	if err != nil {
 		return fmt.Errorf("server unhealthy: %v: %v", healthzContent, err)
 	}
@@ -128,55 +245,346 @@ func WaitForHealthyAPIServer(client rest.Interface) error {
 	return nil
 }
 
-func WaitForAuthorizationUpdate(client authorizationv1client.SubjectAccessReviewsGetter) error {
-	review := &authorizationv1.SubjectAccessReview{
-		Spec: authorizationv1.SubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Group:     "",
+// apiServerHealthz performs a single, non-blocking check of the API server's /healthz
+// endpoint. It is shared by WaitForHealthyAPIServer (which polls it) and the /healthz
+// handler registered by registerDebugHandlers (which reports it on demand).
+func apiServerHealthz(ctx context.Context, client rest.Interface) (bool, string, error) {
+	healthStatus := 0
+	resp := client.Get().AbsPath("/healthz").Do(ctx).StatusCode(&healthStatus)
+	content, _ := resp.Raw()
+	if healthStatus != http.StatusOK {
+		klog.Errorf("Server isn't healthy yet. Waiting a little while.")
+		return false, string(content), nil
+	}
+	return true, string(content), nil
+}
+
+// controllerHealthRegistry backs the aggregate /healthz view: once controllers start
+// reporting their own readiness, they register a check here, and /healthz reports
+// unhealthy until every registered controller reports clean.
+type controllerHealthRegistry struct {
+	mu     sync.Mutex
+	checks map[string]func() error
+}
+
+func newControllerHealthRegistry() *controllerHealthRegistry {
+	return &controllerHealthRegistry{checks: map[string]func() error{}}
+}
+
+func (r *controllerHealthRegistry) register(name string, check func() error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check
+}
+
+// unhealthy returns the names of every registered controller whose check currently
+// returns an error, sorted for stable /healthz output.
+func (r *controllerHealthRegistry) unhealthy() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var names []string
+	for name, check := range r.checks {
+		if err := check(); err != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// registerDebugHandlers wires up the /healthz endpoint (reporting API server
+// reachability plus per-controller readiness via healthChecks) and, when enabled, the
+// pprof debug endpoints used to profile leader election and informer sync storms in
+// production, on mux. This mirrors the debug serving pattern used by
+// kube-controller-manager and cloud-controller-manager.
+func registerDebugHandlers(mux *http.ServeMux, config *openshiftcontrolplanev1.OpenShiftControllerManagerConfig, kubeClient kubernetes.Interface, healthChecks *controllerHealthRegistry) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		ok, content, err := apiServerHealthz(r.Context(), kubeClient.Discovery().RESTClient())
+		if err != nil || !ok {
+			http.Error(w, fmt.Sprintf("api server unhealthy: %s", content), http.StatusServiceUnavailable)
+			return
+		}
+		if unhealthy := healthChecks.unhealthy(); len(unhealthy) > 0 {
+			http.Error(w, fmt.Sprintf("controllers not ready: %v", unhealthy), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	})
+
+	// Profiling and ContentionProfiling are fields this request assumes on
+	// OpenShiftControllerManagerConfig; they aren't vendored in this tree yet, so they
+	// need to land upstream in github.com/openshift/api before this builds against the
+	// real dependency.
+	if !config.Profiling {
+		return
+	}
+
+	if config.ContentionProfiling {
+		runtime.SetBlockProfileRate(1)
+	}
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+}
+
+// authorizationPreflightWorkers bounds how many RequiredPermissions checks
+// WaitForAuthorizationUpdate runs concurrently, so a manager with many registered
+// controllers doesn't serialize its whole RBAC preflight one SubjectAccessReview at a
+// time.
+const authorizationPreflightWorkers = 5
+
+// rbacMissingGauge reports 1 for each controller whose RequiredPermissions haven't been
+// allowed yet, so an operator can tell which controller's RBAC is still propagating
+// without grepping startup logs.
+var rbacMissingGauge = metrics.NewGaugeVec(&metrics.GaugeOpts{
+	Name: "openshift_controller_manager_rbac_missing",
+	Help: "Reports 1 for each controller whose RequiredPermissions SubjectAccessReview hasn't been allowed yet, 0 once it has.",
+}, []string{"controller"})
+
+func init() {
+	legacyregistry.MustRegister(rbacMissingGauge)
+}
+
+// WaitForAuthorizationUpdate blocks until system:kube-controller-manager is permitted
+// every RequiredPermissions entry declared by a controller in descriptors, checking up
+// to authorizationPreflightWorkers of them concurrently. If no registered controller has
+// declared any RequiredPermissions yet, it falls back to the one check every deployment
+// needs regardless of which controllers are enabled: read access to configmaps in
+// openshift-kube-controller-manager. It returns early if ctx is canceled (e.g.
+// leadership lost while still preflighting) instead of running the full 2 minutes out.
+func WaitForAuthorizationUpdate(ctx context.Context, client authorizationv1client.SubjectAccessReviewsGetter, descriptors map[string]origincontrollers.ControllerDescriptor) error {
+	type requirement struct {
+		controller string
+		attrs      authorizationv1.ResourceAttributes
+	}
+
+	var requirements []requirement
+	for name, descriptor := range descriptors {
+		for _, attrs := range descriptor.RequiredPermissions {
+			requirements = append(requirements, requirement{controller: name, attrs: attrs})
+		}
+	}
+	if len(requirements) == 0 {
+		requirements = append(requirements, requirement{
+			attrs: authorizationv1.ResourceAttributes{
 				Verb:      "get",
 				Resource:  "configmaps",
 				Namespace: "openshift-kube-controller-manager",
 			},
-			User: "system:kube-controller-manager",
-		},
+		})
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	work := make(chan requirement)
+	errs := make(chan error, authorizationPreflightWorkers)
+	var missingMu sync.Mutex
+	var missingPermissions []string
+	var wg sync.WaitGroup
+
+	for i := 0; i < authorizationPreflightWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for req := range work {
+				attrs := req.attrs
+				err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+					review := &authorizationv1.SubjectAccessReview{
+						Spec: authorizationv1.SubjectAccessReviewSpec{
+							ResourceAttributes: &attrs,
+							User:               "system:kube-controller-manager",
+						},
+					}
+					response, err := client.SubjectAccessReviews().Create(pollCtx, review, metav1.CreateOptions{})
+					if err != nil {
+						return false, err
+					}
+					if !response.Status.Allowed {
+						rbacMissingGauge.WithLabelValues(req.controller).Set(1)
+						klog.Infof("Waiting for system:kube-controller-manager to be able to %s %s (%q)...", attrs.Verb, attrs.Resource, req.controller)
+						return false, nil
+					}
+					rbacMissingGauge.WithLabelValues(req.controller).Set(0)
+					return true, nil
+				}, pollCtx.Done())
+				if err != nil {
+					missingMu.Lock()
+					missingPermissions = append(missingPermissions, req.controller)
+					missingMu.Unlock()
+					errs <- fmt.Errorf("server missing RBAC policy for system:kube-controller-manager (%q): %v", req.controller, err)
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, req := range requirements {
+		select {
+		case work <- req:
+		case <-pollCtx.Done():
+			break feed
+		}
 	}
-	if err := wait.PollImmediate(time.Second, 2*time.Minute, func() (bool, error) {
-		response, err := client.SubjectAccessReviews().Create(context.TODO(), review, metav1.CreateOptions{})
+	close(work)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
 		if err != nil {
-			return false, err
+			return err
 		}
-		if !response.Status.Allowed {
-			klog.Infof("Waiting for system:kube-controller-manager to be able to access configmaps... ")
-			return false, nil
+	}
+	if pollCtx.Err() != nil {
+		return fmt.Errorf("timed out waiting for RBAC to propagate for: %v", missingPermissions)
+	}
+	return nil
+}
+
+const (
+	controllerStateStarted = "started"
+	controllerStateSkipped = "skipped"
+	controllerStateFailed  = "failed"
+)
+
+// controllerStateGauge reports 1 for each origin controller's current state (started,
+// skipped, or failed), so an operator can tell from metrics alone which controllers came
+// up clean without having to grep startup logs.
+var controllerStateGauge = metrics.NewGaugeVec(&metrics.GaugeOpts{
+	Name: "openshift_controller_manager_controller_state",
+	Help: "Reports 1 for an origin controller's current state (started, skipped, or failed), labeled by controller name and state.",
+}, []string{"controller", "state"})
+
+func init() {
+	legacyregistry.MustRegister(controllerStateGauge)
+}
+
+// firstUnmetRequirement returns the name of the first entry in requires that isn't a
+// registered controller, or "" if every entry is registered. A requirement that's
+// registered but disabled isn't "unmet" in this sense — orderControllers handles that
+// case itself, by skipping the dependent rather than failing outright.
+func firstUnmetRequirement(requires []string, descriptors map[string]origincontrollers.ControllerDescriptor) string {
+	for _, name := range requires {
+		if _, exists := descriptors[name]; !exists {
+			return name
 		}
-		return true, nil
-	}); err != nil {
-		return fmt.Errorf("server missing RBAC policy for system:kube-controller-manager: %v", err)
 	}
+	return ""
+}
 
-	return nil
+// orderControllers returns the registered, enabled controllers in dependency order: a
+// controller only appears after everything named in its Requires. A controller that
+// requires a disabled (or transitively skipped) controller is itself skipped, logged as
+// a warning, rather than started out of order. It returns an error if a Requires name
+// isn't a registered controller at all, or if Requires relationships between enabled
+// controllers form a cycle.
+func orderControllers(descriptors map[string]origincontrollers.ControllerDescriptor, controllerContext *origincontrollers.ControllerContext) ([]origincontrollers.ControllerDescriptor, error) {
+	var names []string
+	for name := range descriptors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		stateUnvisited = iota
+		stateVisiting
+		stateOrdered
+	)
+	state := map[string]int{}
+	skipped := sets.NewString()
+	var ordered []origincontrollers.ControllerDescriptor
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		if state[name] == stateOrdered || skipped.Has(name) {
+			return nil
+		}
+		if state[name] == stateVisiting {
+			return fmt.Errorf("cycle detected in controller dependencies at %q", name)
+		}
+		state[name] = stateVisiting
+
+		descriptor := descriptors[name]
+		if missing := firstUnmetRequirement(descriptor.Requires, descriptors); missing != "" {
+			return fmt.Errorf("controller %q requires %q, which isn't registered", name, missing)
+		}
+		if !controllerContext.IsControllerEnabled(name) {
+			skipped.Insert(name)
+			state[name] = stateOrdered
+			return nil
+		}
+		for _, require := range descriptor.Requires {
+			if err := visit(require); err != nil {
+				return err
+			}
+			if skipped.Has(require) {
+				klog.Warningf("Skipping %q: requires %q, which is disabled", name, require)
+				skipped.Insert(name)
+				state[name] = stateOrdered
+				return nil
+			}
+		}
+
+		state[name] = stateOrdered
+		ordered = append(ordered, descriptor)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
 }
 
-// startControllers launches the controllers
+// startControllers starts every registered, enabled controller in dependency order (see
+// orderControllers). Each controller's HealthCheck, if it has one, is registered both
+// against healthChecks, for the aggregate /healthz view, and as its own
+// /healthz/controller/<name> route on mux, so an operator can narrow in on exactly which
+// controller is unhealthy.
+//
 // allocation controller is passed in because it wants direct etcd access.  Naughty.
-func startControllers(controllerContext *origincontrollers.ControllerContext) error {
-	for controllerName, initFn := range origincontrollers.ControllerInitializers {
-		if !controllerContext.IsControllerEnabled(controllerName) {
-			klog.Warningf("%q is disabled", controllerName)
-			continue
-		}
+func startControllers(controllerContext *origincontrollers.ControllerContext, mux *http.ServeMux, healthChecks *controllerHealthRegistry) error {
+	ordered, err := orderControllers(origincontrollers.ControllerInitializers, controllerContext)
+	if err != nil {
+		return err
+	}
 
-		klog.V(1).Infof("Starting %q", controllerName)
-		started, err := initFn(controllerContext)
+	for _, descriptor := range ordered {
+		klog.V(1).Infof("Starting %q", descriptor.Name)
+		started, err := descriptor.InitFn(controllerContext)
 		if err != nil {
-			klog.Fatalf("Error starting %q (%v)", controllerName, err)
+			controllerStateGauge.WithLabelValues(descriptor.Name, controllerStateFailed).Set(1)
+			klog.Fatalf("Error starting %q (%v)", descriptor.Name, err)
 			return err
 		}
 		if !started {
-			klog.Warningf("Skipping %q", controllerName)
+			klog.Warningf("Skipping %q", descriptor.Name)
+			controllerStateGauge.WithLabelValues(descriptor.Name, controllerStateSkipped).Set(1)
 			continue
 		}
-		klog.Infof("Started %q", controllerName)
+		klog.Infof("Started %q", descriptor.Name)
+		controllerStateGauge.WithLabelValues(descriptor.Name, controllerStateStarted).Set(1)
+
+		if descriptor.HealthCheck != nil {
+			healthChecks.register(descriptor.Name, descriptor.HealthCheck)
+			healthCheck := descriptor.HealthCheck
+			mux.HandleFunc(fmt.Sprintf("/healthz/controller/%s", descriptor.Name), func(w http.ResponseWriter, r *http.Request) {
+				if err := healthCheck(); err != nil {
+					http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, "ok")
+			})
+		}
 	}
 
 	klog.Infof("Started Origin Controllers")
@@ -0,0 +1,214 @@
+package cluster_policy_controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	openshiftcontrolplanev1 "github.com/openshift/api/openshiftcontrolplane/v1"
+	origincontrollers "github.com/openshift/cluster-policy-controller/pkg/cmd/controller"
+)
+
+func TestResolveResourceLockType(t *testing.T) {
+	tests := map[string]struct {
+		configured string
+		want       string
+	}{
+		"unset falls back to configmaps":     {configured: "", want: resourcelock.ConfigMapsResourceLock},
+		"leases is passed through":           {configured: resourcelock.LeasesResourceLock, want: resourcelock.LeasesResourceLock},
+		"configmapsleases is passed through": {configured: resourcelock.ConfigMapsLeasesResourceLock, want: resourcelock.ConfigMapsLeasesResourceLock},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := resolveResourceLockType(test.configured); got != test.want {
+				t.Errorf("resolveResourceLockType(%q) = %q, want %q", test.configured, got, test.want)
+			}
+		})
+	}
+}
+
+// TestResourceLockMigration simulates a rolling upgrade from the configmaps lock to the
+// coordination.k8s.io lease lock via the "configmapsleases" hybrid type: old-holder-only,
+// new-holder-only, and both-agree concurrency, as requested for the resourceLock
+// migration path in RunClusterPolicyController.
+func TestResourceLockMigration(t *testing.T) {
+	const (
+		namespace = "openshift-kube-controller-manager"
+		name      = "cluster-policy-controller"
+	)
+
+	newLock := func(t *testing.T, client *fake.Clientset, lockType, identity string) resourcelock.Interface {
+		t.Helper()
+		lock, err := resourcelock.New(lockType, namespace, name, client.CoreV1(), client.CoordinationV1(), resourcelock.ResourceLockConfig{
+			Identity: identity,
+		})
+		if err != nil {
+			t.Fatalf("resourcelock.New(%q): %v", lockType, err)
+		}
+		return lock
+	}
+
+	record := func(identity string) resourcelock.LeaderElectionRecord {
+		now := metav1.Now()
+		return resourcelock.LeaderElectionRecord{
+			HolderIdentity:       identity,
+			LeaseDurationSeconds: 15,
+			AcquireTime:          now,
+			RenewTime:            now,
+		}
+	}
+
+	t.Run("old-holder only is visible to a migrating holder", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+		oldHolder := newLock(t, client, resourcelock.ConfigMapsResourceLock, "old-holder")
+		if err := oldHolder.Create(context.TODO(), record("old-holder")); err != nil {
+			t.Fatalf("old-holder Create: %v", err)
+		}
+
+		migrating := newLock(t, client, resourcelock.ConfigMapsLeasesResourceLock, "new-holder")
+		got, _, err := migrating.Get(context.TODO())
+		if err != nil {
+			t.Fatalf("migrating holder Get: %v", err)
+		}
+		if got.HolderIdentity != "old-holder" {
+			t.Errorf("migrating holder observed %q, want %q (old-holder's configmap record)", got.HolderIdentity, "old-holder")
+		}
+	})
+
+	t.Run("new-holder only is visible to a migrating holder", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+		newHolder := newLock(t, client, resourcelock.LeasesResourceLock, "new-holder")
+		if err := newHolder.Create(context.TODO(), record("new-holder")); err != nil {
+			t.Fatalf("new-holder Create: %v", err)
+		}
+
+		migrating := newLock(t, client, resourcelock.ConfigMapsLeasesResourceLock, "other")
+		got, _, err := migrating.Get(context.TODO())
+		if err != nil {
+			t.Fatalf("migrating holder Get: %v", err)
+		}
+		if got.HolderIdentity != "new-holder" {
+			t.Errorf("migrating holder observed %q, want %q (new-holder's lease record)", got.HolderIdentity, "new-holder")
+		}
+	})
+
+	t.Run("migrating holder's writes are seen by both old- and new-style watchers", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+		migrating := newLock(t, client, resourcelock.ConfigMapsLeasesResourceLock, "new-holder")
+		if err := migrating.Create(context.TODO(), record("new-holder")); err != nil {
+			t.Fatalf("migrating holder Create: %v", err)
+		}
+		rec := record("new-holder")
+		rec.AcquireTime = metav1.NewTime(rec.AcquireTime.Add(time.Second))
+		if err := migrating.Update(context.TODO(), rec); err != nil {
+			t.Fatalf("migrating holder Update: %v", err)
+		}
+
+		oldStyleWatcher := newLock(t, client, resourcelock.ConfigMapsResourceLock, "observer")
+		gotOld, _, err := oldStyleWatcher.Get(context.TODO())
+		if err != nil {
+			t.Fatalf("old-style watcher Get: %v", err)
+		}
+		if gotOld.HolderIdentity != "new-holder" {
+			t.Errorf("old-style watcher observed %q, want %q", gotOld.HolderIdentity, "new-holder")
+		}
+
+		newStyleWatcher := newLock(t, client, resourcelock.LeasesResourceLock, "observer")
+		gotNew, _, err := newStyleWatcher.Get(context.TODO())
+		if err != nil {
+			t.Fatalf("new-style watcher Get: %v", err)
+		}
+		if gotNew.HolderIdentity != "new-holder" {
+			t.Errorf("new-style watcher observed %q, want %q", gotNew.HolderIdentity, "new-holder")
+		}
+	})
+}
+
+// TestOrderControllers covers the ordering and error cases startControllers relies on:
+// a simple dependency chain, a disabled dependency correctly skipping its dependent, a
+// cycle, and a Requires name that isn't a registered controller.
+func TestOrderControllers(t *testing.T) {
+	newContext := func(t *testing.T, controllers []string) *origincontrollers.ControllerContext {
+		t.Helper()
+		config := openshiftcontrolplanev1.OpenShiftControllerManagerConfig{Controllers: controllers}
+		controllerContext, err := origincontrollers.NewControllerContext(config, nil, nil)
+		if err != nil {
+			t.Fatalf("NewControllerContext: %v", err)
+		}
+		return controllerContext
+	}
+
+	noopInit := func(ctx *origincontrollers.ControllerContext) (bool, error) { return true, nil }
+
+	names := func(descriptors []origincontrollers.ControllerDescriptor) []string {
+		var out []string
+		for _, d := range descriptors {
+			out = append(out, d.Name)
+		}
+		return out
+	}
+
+	t.Run("simple dependency chain starts the dependency first", func(t *testing.T) {
+		descriptors := map[string]origincontrollers.ControllerDescriptor{
+			"a": {Name: "a", InitFn: noopInit},
+			"b": {Name: "b", Requires: []string{"a"}, InitFn: noopInit},
+		}
+		ordered, err := orderControllers(descriptors, newContext(t, nil))
+		if err != nil {
+			t.Fatalf("orderControllers: %v", err)
+		}
+		if got := names(ordered); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+			t.Fatalf("orderControllers order = %v, want [a b]", got)
+		}
+	})
+
+	t.Run("a disabled dependency skips its dependent", func(t *testing.T) {
+		descriptors := map[string]origincontrollers.ControllerDescriptor{
+			"a": {Name: "a", InitFn: noopInit},
+			"b": {Name: "b", Requires: []string{"a"}, InitFn: noopInit},
+		}
+		ordered, err := orderControllers(descriptors, newContext(t, []string{"*", "-a"}))
+		if err != nil {
+			t.Fatalf("orderControllers: %v", err)
+		}
+		if got := names(ordered); len(got) != 0 {
+			t.Fatalf("orderControllers order = %v, want none started (b's requirement a is disabled)", got)
+		}
+	})
+
+	t.Run("a cycle produces an error", func(t *testing.T) {
+		descriptors := map[string]origincontrollers.ControllerDescriptor{
+			"a": {Name: "a", Requires: []string{"b"}, InitFn: noopInit},
+			"b": {Name: "b", Requires: []string{"a"}, InitFn: noopInit},
+		}
+		if _, err := orderControllers(descriptors, newContext(t, nil)); err == nil {
+			t.Fatal("orderControllers returned no error for a cyclic dependency")
+		}
+	})
+
+	t.Run("a Requires name that isn't registered produces an error", func(t *testing.T) {
+		descriptors := map[string]origincontrollers.ControllerDescriptor{
+			"a": {Name: "a", Requires: []string{"ghost"}, InitFn: noopInit},
+		}
+		if _, err := orderControllers(descriptors, newContext(t, nil)); err == nil {
+			t.Fatal("orderControllers returned no error for an unregistered Requires name")
+		}
+	})
+}
+
+func TestFirstUnmetRequirement(t *testing.T) {
+	descriptors := map[string]origincontrollers.ControllerDescriptor{
+		"a": {Name: "a"},
+	}
+	if got := firstUnmetRequirement([]string{"a"}, descriptors); got != "" {
+		t.Errorf("firstUnmetRequirement(registered) = %q, want \"\"", got)
+	}
+	if got := firstUnmetRequirement([]string{"ghost"}, descriptors); got != "ghost" {
+		t.Errorf("firstUnmetRequirement(unregistered) = %q, want %q", got, "ghost")
+	}
+}
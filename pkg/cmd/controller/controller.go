@@ -0,0 +1,99 @@
+// Package controller provides the plumbing RunClusterPolicyController uses to start and
+// serve the individual origin controllers: the shared ControllerContext each one is
+// handed, and the registry they're looked up through.
+package controller
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog"
+
+	openshiftcontrolplanev1 "github.com/openshift/api/openshiftcontrolplane/v1"
+)
+
+// InitFunc is the entry point every controller registers under ControllerInitializers.
+// The returned bool reports whether the controller actually started; false lets callers
+// log and move on instead of treating "intentionally not applicable" as an error.
+type InitFunc func(ctx *ControllerContext) (bool, error)
+
+// ControllerDescriptor is how a controller registers itself: its InitFunc, the names of
+// any other controllers it Requires to have started first, an optional HealthCheck
+// startControllers can register against the manager's aggregate /healthz once the
+// controller itself has started, and the RBAC permissions WaitForAuthorizationUpdate
+// confirms system:kube-controller-manager holds before any controller starts.
+type ControllerDescriptor struct {
+	Name                string
+	Requires            []string
+	InitFn              InitFunc
+	HealthCheck         func() error
+	RequiredPermissions []authorizationv1.ResourceAttributes
+}
+
+// ControllerInitializers is populated by each controller's own package, via
+// RegisterController in that package's init(), so that adding a new controller never
+// requires touching this package.
+var ControllerInitializers = map[string]ControllerDescriptor{}
+
+// RegisterController adds descriptor to ControllerInitializers under its Name. It panics
+// on a duplicate name, since that always indicates a programming error rather than a
+// runtime condition a caller could sensibly recover from.
+func RegisterController(descriptor ControllerDescriptor) {
+	if _, exists := ControllerInitializers[descriptor.Name]; exists {
+		panic("controller " + descriptor.Name + " is already registered")
+	}
+	ControllerInitializers[descriptor.Name] = descriptor
+}
+
+// ControllerContext carries everything a controller's InitFunc needs to build and run
+// itself: the manager's config, a client config to build its own clients from, which
+// controllers are enabled, and the stop channel that signals shutdown.
+type ControllerContext struct {
+	OpenshiftConfig openshiftcontrolplanev1.OpenShiftControllerManagerConfig
+	ClientConfig    *rest.Config
+
+	enabledControllers sets.String
+	StopCh             <-chan struct{}
+}
+
+// NewControllerContext builds the shared ControllerContext every controller's InitFunc
+// is handed by startControllers.
+func NewControllerContext(config openshiftcontrolplanev1.OpenShiftControllerManagerConfig, clientConfig *rest.Config, stopCh <-chan struct{}) (*ControllerContext, error) {
+	return &ControllerContext{
+		OpenshiftConfig:    config,
+		ClientConfig:       clientConfig,
+		enabledControllers: resolveEnabledControllers(config.Controllers),
+		StopCh:             stopCh,
+	}, nil
+}
+
+// resolveEnabledControllers turns a --controllers=*,-foo,+bar style list into a set
+// IsControllerEnabled can check directly, defaulting to "everything enabled" when the
+// list is empty.
+//
+// Controllers is a field this series assumes on OpenShiftControllerManagerConfig; it
+// isn't vendored in this tree yet, so it needs to land upstream in
+// github.com/openshift/api before this builds against the real dependency.
+func resolveEnabledControllers(names []string) sets.String {
+	if len(names) == 0 {
+		return sets.NewString("*")
+	}
+	return sets.NewString(names...)
+}
+
+// IsControllerEnabled reports whether name is enabled, mirroring kube-controller-manager's
+// --controllers semantics: an explicit "-name" always disables it; otherwise it's enabled
+// if the list contains "*", "name", or "+name".
+func (c *ControllerContext) IsControllerEnabled(name string) bool {
+	if c.enabledControllers.Has("-" + name) {
+		return false
+	}
+	return c.enabledControllers.Has("*") || c.enabledControllers.Has(name) || c.enabledControllers.Has("+"+name)
+}
+
+// StartInformers starts no informers itself. Individual InitFuncs are responsible for
+// starting their own informer factories with a reference to stopCh; this just logs so
+// operators can see the point at which the manager considers itself fully started.
+func (c *ControllerContext) StartInformers(stopCh <-chan struct{}) {
+	klog.V(1).Info("Starting shared informers")
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog"
+)
+
+// RunControllerServer starts the HTTP server the controller manager exposes its
+// /metrics endpoint on, plus whatever additional routes the caller has already
+// registered on mux (e.g. /healthz and /debug/pprof/*, see registerDebugHandlers in
+// pkg/cmd/cluster-policy-controller). It returns immediately: binding the listener and
+// serving both happen in a background goroutine, and a failure there exits the process
+// via klog.Fatalf rather than being returned.
+func RunControllerServer(servingInfo configv1.HTTPServingInfo, kubeClient kubernetes.Interface, mux *http.ServeMux) error {
+	mux.Handle("/metrics", legacyregistry.Handler())
+
+	go func() {
+		if err := http.ListenAndServe(servingInfo.BindAddress, mux); err != nil {
+			klog.Fatalf("controller server failed: %v", err)
+		}
+	}()
+
+	return nil
+}